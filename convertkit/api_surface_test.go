@@ -0,0 +1,378 @@
+package convertkit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func newAPISurfaceTestClient(t *testing.T, endpoint string) *Client {
+	t.Helper()
+	c, err := NewClient(&Config{
+		Endpoint:  endpoint,
+		Key:       "key",
+		Secret:    "secret",
+		RateLimit: 1000,
+		RateBurst: 1000,
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return c
+}
+
+func TestCreateTagAndListTags(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/tags":
+			var body struct {
+				APISecret string `json:"api_secret"`
+				Tag       struct {
+					Name string `json:"name"`
+				} `json:"tag"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode request: %v", err)
+			}
+			if body.APISecret != "secret" {
+				t.Fatalf("api_secret = %q, want %q", body.APISecret, "secret")
+			}
+			json.NewEncoder(w).Encode(Tag{ID: 1, Name: body.Tag.Name})
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/tags":
+			json.NewEncoder(w).Encode(struct {
+				Tags []Tag `json:"tags"`
+			}{Tags: []Tag{{ID: 1, Name: "customer"}}})
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := newAPISurfaceTestClient(t, srv.URL)
+
+	tag, err := c.CreateTag(context.Background(), "customer")
+	if err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+	if tag.Name != "customer" {
+		t.Fatalf("CreateTag name = %q, want %q", tag.Name, "customer")
+	}
+
+	tags, err := c.ListTags(context.Background())
+	if err != nil {
+		t.Fatalf("ListTags: %v", err)
+	}
+	if len(tags) != 1 || tags[0].Name != "customer" {
+		t.Fatalf("ListTags = %+v, want one tag named customer", tags)
+	}
+}
+
+func TestAddSubscriberToFormAndSequence(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			APIKey string `json:"api_key"`
+			Email  string `json:"email"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if body.Email != "reader@example.com" {
+			t.Fatalf("email = %q, want %q", body.Email, "reader@example.com")
+		}
+
+		switch {
+		case r.URL.Path == "/v3/forms/42/subscribe":
+			json.NewEncoder(w).Encode(struct {
+				Subscription Subscription `json:"subscription"`
+			}{Subscription: Subscription{ID: 1, State: "active"}})
+		case r.URL.Path == "/v3/sequences/7/subscribe":
+			json.NewEncoder(w).Encode(struct {
+				Subscription Subscription `json:"subscription"`
+			}{Subscription: Subscription{ID: 2, State: "active"}})
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := newAPISurfaceTestClient(t, srv.URL)
+
+	sub, err := c.AddSubscriberToForm(context.Background(), 42, "reader@example.com")
+	if err != nil {
+		t.Fatalf("AddSubscriberToForm: %v", err)
+	}
+	if sub.ID != 1 {
+		t.Fatalf("AddSubscriberToForm subscription ID = %d, want 1", sub.ID)
+	}
+
+	sub, err = c.AddSubscriberToSequence(context.Background(), 7, "reader@example.com")
+	if err != nil {
+		t.Fatalf("AddSubscriberToSequence: %v", err)
+	}
+	if sub.ID != 2 {
+		t.Fatalf("AddSubscriberToSequence subscription ID = %d, want 2", sub.ID)
+	}
+}
+
+func TestCreateBroadcastAndBroadcastStats(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/broadcasts":
+			var body struct {
+				CreateBroadcastRequest
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode request: %v", err)
+			}
+			if body.Subject != "Hello" {
+				t.Fatalf("subject = %q, want %q", body.Subject, "Hello")
+			}
+			json.NewEncoder(w).Encode(Broadcast{ID: 9, Subject: body.Subject})
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/broadcasts/9/stats":
+			json.NewEncoder(w).Encode(struct {
+				Broadcast struct {
+					Stats BroadcastStatsResult `json:"stats"`
+				} `json:"broadcast"`
+			}{Broadcast: struct {
+				Stats BroadcastStatsResult `json:"stats"`
+			}{Stats: BroadcastStatsResult{Recipients: 100, OpenRate: 0.5}}})
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := newAPISurfaceTestClient(t, srv.URL)
+
+	broadcast, err := c.CreateBroadcast(context.Background(), CreateBroadcastRequest{Subject: "Hello"})
+	if err != nil {
+		t.Fatalf("CreateBroadcast: %v", err)
+	}
+	if broadcast.ID != 9 {
+		t.Fatalf("CreateBroadcast ID = %d, want 9", broadcast.ID)
+	}
+
+	stats, err := c.BroadcastStats(context.Background(), broadcast.ID)
+	if err != nil {
+		t.Fatalf("BroadcastStats: %v", err)
+	}
+	if stats.Recipients != 100 {
+		t.Fatalf("BroadcastStats.Recipients = %d, want 100", stats.Recipients)
+	}
+}
+
+func TestCreatePurchaseAndListPurchases(t *testing.T) {
+	const totalPages = 3
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/purchases":
+			var body struct {
+				Purchase CreatePurchaseRequest `json:"purchase"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode request: %v", err)
+			}
+			json.NewEncoder(w).Encode(struct {
+				Purchase Purchase `json:"purchase"`
+			}{Purchase: Purchase{ID: 1, TransactionID: body.Purchase.TransactionID}})
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/purchases":
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			if page < 1 {
+				page = 1
+			}
+			json.NewEncoder(w).Encode(purchasePage{
+				TotalPurchases: totalPages,
+				Page:           page,
+				TotalPages:     totalPages,
+				Purchases:      []Purchase{{ID: page}},
+			})
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := newAPISurfaceTestClient(t, srv.URL)
+
+	purchase, err := c.CreatePurchase(context.Background(), CreatePurchaseRequest{TransactionID: "txn-1"})
+	if err != nil {
+		t.Fatalf("CreatePurchase: %v", err)
+	}
+	if purchase.TransactionID != "txn-1" {
+		t.Fatalf("CreatePurchase transaction ID = %q, want %q", purchase.TransactionID, "txn-1")
+	}
+
+	purchases, err := c.ListPurchases(context.Background())
+	if err != nil {
+		t.Fatalf("ListPurchases: %v", err)
+	}
+	if len(purchases) != totalPages {
+		t.Fatalf("ListPurchases returned %d purchases, want %d (pagination not followed)", len(purchases), totalPages)
+	}
+	for i, p := range purchases {
+		if p.ID != i+1 {
+			t.Fatalf("purchases[%d].ID = %d, want %d (pages delivered out of order)", i, p.ID, i+1)
+		}
+	}
+}
+
+func TestListForms(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v3/forms" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("api_secret") != "secret" {
+			t.Fatalf("api_secret = %q, want %q", r.URL.Query().Get("api_secret"), "secret")
+		}
+		json.NewEncoder(w).Encode(struct {
+			Forms []Form `json:"forms"`
+		}{Forms: []Form{{ID: 1, Name: "Newsletter"}}})
+	}))
+	defer srv.Close()
+
+	c := newAPISurfaceTestClient(t, srv.URL)
+
+	forms, err := c.ListForms(context.Background())
+	if err != nil {
+		t.Fatalf("ListForms: %v", err)
+	}
+	if len(forms) != 1 || forms[0].Name != "Newsletter" {
+		t.Fatalf("ListForms = %+v, want one form named Newsletter", forms)
+	}
+}
+
+func TestListSequences(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v3/sequences" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("api_secret") != "secret" {
+			t.Fatalf("api_secret = %q, want %q", r.URL.Query().Get("api_secret"), "secret")
+		}
+		json.NewEncoder(w).Encode(struct {
+			Sequences []Sequence `json:"courses"`
+		}{Sequences: []Sequence{{ID: 1, Name: "Onboarding"}}})
+	}))
+	defer srv.Close()
+
+	c := newAPISurfaceTestClient(t, srv.URL)
+
+	sequences, err := c.ListSequences(context.Background())
+	if err != nil {
+		t.Fatalf("ListSequences: %v", err)
+	}
+	if len(sequences) != 1 || sequences[0].Name != "Onboarding" {
+		t.Fatalf("ListSequences = %+v, want one sequence named Onboarding (check the \"courses\" unmarshal key)", sequences)
+	}
+}
+
+func TestCustomFieldLifecycle(t *testing.T) {
+	var deleted bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/custom_fields":
+			json.NewEncoder(w).Encode(struct {
+				CustomFields []CustomField `json:"custom_fields"`
+			}{CustomFields: []CustomField{{ID: 1, Label: "Favorite Color"}}})
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/custom_fields":
+			var body struct {
+				APISecret string `json:"api_secret"`
+				Label     string `json:"label"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode request: %v", err)
+			}
+			json.NewEncoder(w).Encode(CustomField{ID: 2, Label: body.Label})
+		case r.Method == http.MethodPut && r.URL.Path == "/v3/custom_fields/2":
+			var body struct {
+				APISecret string `json:"api_secret"`
+				Label     string `json:"label"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode request: %v", err)
+			}
+			if body.APISecret != "secret" {
+				t.Fatalf("api_secret = %q, want %q", body.APISecret, "secret")
+			}
+			json.NewEncoder(w).Encode(CustomField{ID: 2, Label: body.Label})
+		case r.Method == http.MethodDelete && r.URL.Path == "/v3/custom_fields/2":
+			if r.URL.Query().Get("api_secret") != "secret" {
+				t.Fatalf("api_secret = %q, want %q", r.URL.Query().Get("api_secret"), "secret")
+			}
+			deleted = true
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := newAPISurfaceTestClient(t, srv.URL)
+
+	fields, err := c.ListCustomFields(context.Background())
+	if err != nil {
+		t.Fatalf("ListCustomFields: %v", err)
+	}
+	if len(fields) != 1 || fields[0].Label != "Favorite Color" {
+		t.Fatalf("ListCustomFields = %+v, want one field labeled Favorite Color", fields)
+	}
+
+	field, err := c.CreateCustomField(context.Background(), "Shoe Size")
+	if err != nil {
+		t.Fatalf("CreateCustomField: %v", err)
+	}
+	if field.Label != "Shoe Size" {
+		t.Fatalf("CreateCustomField label = %q, want %q", field.Label, "Shoe Size")
+	}
+
+	field, err = c.UpdateCustomField(context.Background(), field.ID, "Preferred Shoe Size")
+	if err != nil {
+		t.Fatalf("UpdateCustomField: %v", err)
+	}
+	if field.Label != "Preferred Shoe Size" {
+		t.Fatalf("UpdateCustomField label = %q, want %q", field.Label, "Preferred Shoe Size")
+	}
+
+	if err := c.DeleteCustomField(context.Background(), field.ID); err != nil {
+		t.Fatalf("DeleteCustomField: %v", err)
+	}
+	if !deleted {
+		t.Fatal("DeleteCustomField did not reach the server")
+	}
+}
+
+func TestRemoveTagFromSubscriber(t *testing.T) {
+	var removed bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/tags/7/unsubscribe" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			APIKey string `json:"api_key"`
+			Email  string `json:"email"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if body.APIKey != "key" || body.Email != "reader@example.com" {
+			t.Fatalf("body = %+v, want api_key=%q email=%q", body, "key", "reader@example.com")
+		}
+		removed = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := newAPISurfaceTestClient(t, srv.URL)
+
+	if err := c.RemoveTagFromSubscriber(context.Background(), 7, "reader@example.com"); err != nil {
+		t.Fatalf("RemoveTagFromSubscriber: %v", err)
+	}
+	if !removed {
+		t.Fatal("RemoveTagFromSubscriber did not reach the server")
+	}
+}
@@ -0,0 +1,89 @@
+package convertkit
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Broadcast describes a ConvertKit broadcast email.
+type Broadcast struct {
+	ID          int       `json:"id"`
+	Subject     string    `json:"subject"`
+	Description string    `json:"description"`
+	Public      bool      `json:"public"`
+	PublishedAt time.Time `json:"published_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// CreateBroadcastRequest is the set of parameters used to create a
+// broadcast.
+type CreateBroadcastRequest struct {
+	Content     string     `json:"content"`
+	Description string     `json:"description"`
+	Public      bool       `json:"public"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	Subject     string     `json:"subject"`
+}
+
+// BroadcastStatsResult reports delivery and engagement stats for one
+// broadcast.
+type BroadcastStatsResult struct {
+	Recipients   int     `json:"recipients"`
+	OpenRate     float64 `json:"open_rate"`
+	ClickRate    float64 `json:"click_rate"`
+	Unsubscribes int     `json:"unsubscribes"`
+	TotalClicks  int     `json:"total_clicks"`
+	ShowTotal    bool    `json:"show_total_clicks"`
+}
+
+// ListBroadcasts returns every broadcast created for the account.
+func (c *Client) ListBroadcasts(ctx context.Context, opts ...CallOption) ([]Broadcast, error) {
+	if c.config.Secret == "" {
+		return nil, ErrSecretMissing
+	}
+
+	url := fmt.Sprintf("%s/v3/broadcasts?api_secret=%s", c.config.Endpoint, c.config.Secret)
+	var resp struct {
+		Broadcasts []Broadcast `json:"broadcasts"`
+	}
+	if err := c.sendRequest(ctx, "GET", url, nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp.Broadcasts, nil
+}
+
+// CreateBroadcast creates a new broadcast.
+func (c *Client) CreateBroadcast(ctx context.Context, req CreateBroadcastRequest, opts ...CallOption) (Broadcast, error) {
+	if c.config.Secret == "" {
+		return Broadcast{}, ErrSecretMissing
+	}
+
+	body := struct {
+		APISecret string `json:"api_secret"`
+		CreateBroadcastRequest
+	}{APISecret: c.config.Secret, CreateBroadcastRequest: req}
+
+	var broadcast Broadcast
+	err := c.postJSON(ctx, "/v3/broadcasts", body, &broadcast, opts...)
+	return broadcast, err
+}
+
+// BroadcastStats returns delivery and engagement stats for the broadcast
+// with the given id.
+func (c *Client) BroadcastStats(ctx context.Context, id int, opts ...CallOption) (BroadcastStatsResult, error) {
+	if c.config.Secret == "" {
+		return BroadcastStatsResult{}, ErrSecretMissing
+	}
+
+	url := fmt.Sprintf("%s/v3/broadcasts/%d/stats?api_secret=%s", c.config.Endpoint, id, c.config.Secret)
+	var resp struct {
+		Broadcast struct {
+			Stats BroadcastStatsResult `json:"stats"`
+		} `json:"broadcast"`
+	}
+	if err := c.sendRequest(ctx, "GET", url, nil, &resp, opts...); err != nil {
+		return BroadcastStatsResult{}, err
+	}
+	return resp.Broadcast.Stats, nil
+}
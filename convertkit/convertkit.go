@@ -3,17 +3,16 @@
 package convertkit
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"os"
 	"strings"
 	"time"
 
-	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
 )
 
 var (
@@ -33,6 +32,19 @@ type Config struct {
 	ConcurrentRequests int
 
 	HTTPClient *http.Client
+
+	// RetryPolicy controls how the client retries failed requests. It
+	// defaults to DefaultRetryPolicy(); set it to &RetryPolicy{} to disable
+	// retries entirely.
+	RetryPolicy *RetryPolicy
+
+	// RateLimit and RateBurst configure the token-bucket rate limiter every
+	// request waits on, independent of ConcurrentRequests. They default to
+	// 2 requests/sec with a burst of 10: the sustained rate equals
+	// ConvertKit's documented 120 requests/minute cap, and a full burst can
+	// briefly exceed it.
+	RateLimit rate.Limit
+	RateBurst int
 }
 
 // DefaultConfig returns a default configuration for the client. It parses the
@@ -43,6 +55,9 @@ func DefaultConfig() *Config {
 		Endpoint:           "https://api.convertkit.com",
 		ConcurrentRequests: 8,
 		HTTPClient:         http.DefaultClient,
+		RetryPolicy:        DefaultRetryPolicy(),
+		RateLimit:          defaultRateLimit,
+		RateBurst:          defaultRateBurst,
 	}
 	if v := os.Getenv("CONVERTKIT_API_ENDPOINT"); v != "" {
 		c.Endpoint = v
@@ -58,7 +73,8 @@ func DefaultConfig() *Config {
 
 // Client is the client to the ConvertKit API. Create a client with NewClient.
 type Client struct {
-	config *Config
+	config  *Config
+	limiter *rateLimiter
 }
 
 // NewClient returns a new client for the given configuration.
@@ -79,7 +95,19 @@ func NewClient(c *Config) (*Client, error) {
 	if c.HTTPClient == nil {
 		c.HTTPClient = defConfig.HTTPClient
 	}
-	return &Client{config: c}, nil
+	if c.RetryPolicy == nil {
+		c.RetryPolicy = defConfig.RetryPolicy
+	}
+	if c.RateLimit == 0 {
+		c.RateLimit = defConfig.RateLimit
+	}
+	if c.RateBurst == 0 {
+		c.RateBurst = defConfig.RateBurst
+	}
+	return &Client{
+		config:  c,
+		limiter: newRateLimiter(c.RateLimit, c.RateBurst),
+	}, nil
 }
 
 // SubscriberQuery parameterizes what subscriber data to request.
@@ -144,52 +172,49 @@ func (r *SubscriptionRequest) AddTag(newTag int) {
 }
 
 // Subscribers returns a list of all confirmed subscribers.
+//
+// Deprecated: use SubscribersContext so calls can be bound to a caller's
+// deadline and cancelled when no longer needed.
 func (c *Client) Subscribers(query *SubscriberQuery) ([]Subscriber, error) {
-	p, err := c.subscriberPage(1, query)
-	if err != nil {
-		return nil, err
-	}
-
-	total := p.TotalPages
-	if total <= 1 {
-		return p.Subscribers, nil
-	}
-
-	var g errgroup.Group
-	limiter := make(chan bool, c.config.ConcurrentRequests)
-
-	pages := make([]subscriberPage, total)
-	pages[0] = *p
-
-	for i := 2; i <= total; i++ {
-		i := i // see https://golang.org/doc/faq#closures_and_goroutines
-		g.Go(func() error {
-			limiter <- true
-			defer func() { <-limiter }()
-
-			p, err := c.subscriberPage(i, query)
-			if err == nil {
-				pages[i-1] = *p
-			}
-			return err
-		})
-	}
+	return c.SubscribersContext(context.Background(), query)
+}
 
-	if err := g.Wait(); err != nil {
-		return nil, err
-	}
+// SubscribersContext returns a list of all confirmed subscribers. Cancelling
+// ctx stops any in-flight page fetches and returns ctx.Err().
+//
+// It is a convenience wrapper that drains SubscribersIter; callers that want
+// to stream subscribers instead of buffering them all in memory should use
+// SubscribersIter directly.
+func (c *Client) SubscribersContext(ctx context.Context, query *SubscriberQuery, opts ...CallOption) ([]Subscriber, error) {
+	it := c.SubscribersIter(ctx, query, opts...)
+	defer it.Close()
 
 	var subscribers []Subscriber
-	for i := 0; i < total; i++ {
-		subscribers = append(subscribers, pages[i].Subscribers...)
+	for {
+		s, err := it.Next()
+		if err == ErrIteratorDone {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		subscribers = append(subscribers, s)
 	}
 
 	return subscribers, nil
 }
 
 // TotalSubscribers returns the number of confirmed subscribers.
+//
+// Deprecated: use TotalSubscribersContext so calls can be bound to a caller's
+// deadline and cancelled when no longer needed.
 func (c *Client) TotalSubscribers() (int, error) {
-	p, err := c.subscriberPage(1, nil)
+	return c.TotalSubscribersContext(context.Background())
+}
+
+// TotalSubscribersContext returns the number of confirmed subscribers.
+func (c *Client) TotalSubscribersContext(ctx context.Context, opts ...CallOption) (int, error) {
+	p, err := c.subscriberPage(ctx, 1, nil, opts...)
 	if err != nil {
 		return 0, err
 	}
@@ -199,17 +224,35 @@ func (c *Client) TotalSubscribers() (int, error) {
 // TagSubscriber adds a tag to a subscriber
 //
 // This method will also create a subscriber with the email address provided if one does not exist.
+//
+// Deprecated: use TagSubscriberContext so calls can be bound to a caller's
+// deadline and cancelled when no longer needed.
 func (c *Client) TagSubscriber(email string, tagID int) (Subscription, error) {
+	return c.TagSubscriberContext(context.Background(), email, tagID)
+}
 
-	return c.CreateTagSubscription(SubscriptionRequest{
+// TagSubscriberContext adds a tag to a subscriber.
+//
+// This method will also create a subscriber with the email address provided if one does not exist.
+func (c *Client) TagSubscriberContext(ctx context.Context, email string, tagID int, opts ...CallOption) (Subscription, error) {
+	return c.CreateTagSubscriptionContext(ctx, SubscriptionRequest{
 		Email: email,
 		Tags:  []int{tagID},
-	})
+	}, opts...)
 }
 
 // CreateTagSubscription tags a subscriber with a tag whild allowing access to set the optional parameters
 // allowed by ConvertKit through a `SubscriptionRequest`
+//
+// Deprecated: use CreateTagSubscriptionContext so calls can be bound to a
+// caller's deadline and cancelled when no longer needed.
 func (c *Client) CreateTagSubscription(req SubscriptionRequest) (Subscription, error) {
+	return c.CreateTagSubscriptionContext(context.Background(), req)
+}
+
+// CreateTagSubscriptionContext tags a subscriber with a tag whild allowing access to set the optional parameters
+// allowed by ConvertKit through a `SubscriptionRequest`
+func (c *Client) CreateTagSubscriptionContext(ctx context.Context, req SubscriptionRequest, opts ...CallOption) (Subscription, error) {
 	subscription := Subscription{}
 	if len(req.Tags) < 1 {
 		return subscription, errors.New("Must specify at least one Tag to create a subscription")
@@ -223,11 +266,11 @@ func (c *Client) CreateTagSubscription(req SubscriptionRequest) (Subscription, e
 	response := struct {
 		Subscription Subscription `json:"subscription"`
 	}{}
-	err := c.postJSON(path, req, &response)
+	err := c.postJSON(ctx, path, req, &response, opts...)
 	return response.Subscription, err
 }
 
-func (c *Client) subscriberPage(page int, query *SubscriberQuery) (*subscriberPage, error) {
+func (c *Client) subscriberPage(ctx context.Context, page int, query *SubscriberQuery, opts ...CallOption) (*subscriberPage, error) {
 	if c.config.Secret == "" {
 		return nil, ErrSecretMissing
 	}
@@ -262,7 +305,7 @@ func (c *Client) subscriberPage(page int, query *SubscriberQuery) (*subscriberPa
 	}
 
 	var p subscriberPage
-	if err := c.sendRequest("GET", url, nil, &p); err != nil {
+	if err := c.sendRequest(ctx, "GET", url, nil, &p, opts...); err != nil {
 		return nil, err
 	}
 
@@ -280,48 +323,95 @@ func parseDate(date string) (string, error) {
 	return date, nil
 }
 
-func (c *Client) sendRequest(method, url string, body io.Reader, out interface{}) error {
-	req, err := http.NewRequest(method, url, body)
-	if err != nil {
-		return err
-	}
+func (c *Client) sendRequest(ctx context.Context, method, url string, body []byte, out interface{}, opts ...CallOption) error {
+	settings := c.callSettings(opts)
 
-	resp, err := c.config.HTTPClient.Do(req)
+	resp, err := c.doWithRetry(ctx, method, url, nil, body, settings.retry)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("HTTP error: %s", resp.Status)
-	}
-
 	return json.NewDecoder(resp.Body).Decode(out)
 }
 
-func (c *Client) postJSON(path string, reqStruct, out interface{}) error {
+func (c *Client) postJSON(ctx context.Context, path string, reqStruct, out interface{}, opts ...CallOption) error {
+	return c.sendJSON(ctx, "POST", path, reqStruct, out, opts...)
+}
+
+func (c *Client) putJSON(ctx context.Context, path string, reqStruct, out interface{}, opts ...CallOption) error {
+	return c.sendJSON(ctx, "PUT", path, reqStruct, out, opts...)
+}
+
+func (c *Client) sendJSON(ctx context.Context, method, path string, reqStruct, out interface{}, opts ...CallOption) error {
+	settings := c.callSettings(opts)
+
 	body, err := json.Marshal(reqStruct)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", c.config.Endpoint+path, bytes.NewBuffer(body))
+	headers := map[string]string{"Content-Type": "application/json"}
+	resp, err := c.doWithRetry(ctx, method, c.config.Endpoint+path, headers, body, settings.retry)
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 
-	req.Header.Set("Content-Type", "application/json")
+	return json.NewDecoder(resp.Body).Decode(out)
+}
 
-	resp, err := c.config.HTTPClient.Do(req)
+// Endpoint returns the client's configured API base URL.
+func (c *Client) Endpoint() string { return c.config.Endpoint }
+
+// Secret returns the client's configured API secret.
+func (c *Client) Secret() string { return c.config.Secret }
+
+// Call performs an arbitrary request against path (relative to Endpoint),
+// retrying and rate limiting it the same as the client's own methods. reqBody,
+// if non-nil, is marshalled as the JSON request body; out, if non-nil,
+// receives the decoded JSON response body. It exists so that subpackages
+// (e.g. convertkit/webhook) can build on the client's transport instead of
+// reimplementing it.
+func (c *Client) Call(ctx context.Context, method, path string, reqBody, out interface{}, opts ...CallOption) error {
+	var body []byte
+	var headers map[string]string
+	if reqBody != nil {
+		b, err := json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+		body = b
+		headers = map[string]string{"Content-Type": "application/json"}
+	}
+
+	settings := c.callSettings(opts)
+	resp, err := c.doWithRetry(ctx, method, c.config.Endpoint+path, headers, body, settings.retry)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("HTTP error: %s", resp.Status)
+	if out == nil {
+		return nil
 	}
-
 	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// doNoContent performs a request and discards the response body, for
+// endpoints that don't return a JSON payload worth decoding (e.g. DELETE). A
+// non-nil body is sent as a JSON request body.
+func (c *Client) doNoContent(ctx context.Context, method, url string, body []byte, opts ...CallOption) error {
+	settings := c.callSettings(opts)
+
+	var headers map[string]string
+	if body != nil {
+		headers = map[string]string{"Content-Type": "application/json"}
+	}
 
+	resp, err := c.doWithRetry(ctx, method, url, headers, body, settings.retry)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
 }
@@ -0,0 +1,74 @@
+package convertkit
+
+import (
+	"context"
+	"fmt"
+)
+
+// CustomField describes a custom field that can be set on a subscriber via
+// SubscriptionRequest.Fields.
+type CustomField struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+// ListCustomFields returns every custom field defined in the account.
+func (c *Client) ListCustomFields(ctx context.Context, opts ...CallOption) ([]CustomField, error) {
+	if c.config.Secret == "" {
+		return nil, ErrSecretMissing
+	}
+
+	url := fmt.Sprintf("%s/v3/custom_fields?api_secret=%s", c.config.Endpoint, c.config.Secret)
+	var resp struct {
+		CustomFields []CustomField `json:"custom_fields"`
+	}
+	if err := c.sendRequest(ctx, "GET", url, nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp.CustomFields, nil
+}
+
+// CreateCustomField creates a new custom field with the given label.
+func (c *Client) CreateCustomField(ctx context.Context, label string, opts ...CallOption) (CustomField, error) {
+	if c.config.Secret == "" {
+		return CustomField{}, ErrSecretMissing
+	}
+
+	body := struct {
+		APISecret string `json:"api_secret"`
+		Label     string `json:"label"`
+	}{APISecret: c.config.Secret, Label: label}
+
+	var field CustomField
+	err := c.postJSON(ctx, "/v3/custom_fields", body, &field, opts...)
+	return field, err
+}
+
+// UpdateCustomField renames the custom field with the given id.
+func (c *Client) UpdateCustomField(ctx context.Context, id int, label string, opts ...CallOption) (CustomField, error) {
+	if c.config.Secret == "" {
+		return CustomField{}, ErrSecretMissing
+	}
+
+	body := struct {
+		APISecret string `json:"api_secret"`
+		Label     string `json:"label"`
+	}{APISecret: c.config.Secret, Label: label}
+
+	path := fmt.Sprintf("/v3/custom_fields/%d", id)
+	var field CustomField
+	err := c.putJSON(ctx, path, body, &field, opts...)
+	return field, err
+}
+
+// DeleteCustomField deletes the custom field with the given id.
+func (c *Client) DeleteCustomField(ctx context.Context, id int, opts ...CallOption) error {
+	if c.config.Secret == "" {
+		return ErrSecretMissing
+	}
+
+	url := fmt.Sprintf("%s/v3/custom_fields/%d?api_secret=%s", c.config.Endpoint, id, c.config.Secret)
+	return c.doNoContent(ctx, "DELETE", url, nil, opts...)
+}
@@ -0,0 +1,45 @@
+package convertkit
+
+import (
+	"context"
+	"fmt"
+)
+
+// Form is a ConvertKit landing page or embeddable signup form.
+type Form struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ListForms returns every form defined in the account.
+func (c *Client) ListForms(ctx context.Context, opts ...CallOption) ([]Form, error) {
+	if c.config.Secret == "" {
+		return nil, ErrSecretMissing
+	}
+
+	url := fmt.Sprintf("%s/v3/forms?api_secret=%s", c.config.Endpoint, c.config.Secret)
+	var resp struct {
+		Forms []Form `json:"forms"`
+	}
+	if err := c.sendRequest(ctx, "GET", url, nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp.Forms, nil
+}
+
+// AddSubscriberToForm subscribes email to the given form.
+//
+// This method will also create a subscriber with the email address provided if one does not exist.
+func (c *Client) AddSubscriberToForm(ctx context.Context, formID int, email string, opts ...CallOption) (Subscription, error) {
+	body := struct {
+		APIKey string `json:"api_key"`
+		Email  string `json:"email"`
+	}{APIKey: c.config.Key, Email: email}
+
+	path := fmt.Sprintf("/v3/forms/%d/subscribe", formID)
+	var resp struct {
+		Subscription Subscription `json:"subscription"`
+	}
+	err := c.postJSON(ctx, path, body, &resp, opts...)
+	return resp.Subscription, err
+}
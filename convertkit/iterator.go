@@ -0,0 +1,209 @@
+package convertkit
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrIteratorDone is returned by SubscriberIterator.Next when there are no
+// more subscribers to return.
+var ErrIteratorDone = errors.New("convertkit: no more subscribers")
+
+// PageInfo describes the pagination state of a SubscriberIterator.
+type PageInfo struct {
+	// Page is the page most recently delivered by Next.
+	Page int
+
+	// TotalPages is the total number of subscriber pages for the query.
+	TotalPages int
+
+	// TotalSubscribers is the total number of subscribers matching the query.
+	TotalSubscribers int
+}
+
+// SubscriberIterator yields subscribers one at a time, fetching pages ahead
+// of consumption so that the caller rarely blocks on the network. Create one
+// with Client.SubscribersIter or Client.SubscribersIterFrom. Call Close when
+// done with an iterator that hasn't been drained to ErrIteratorDone, so its
+// prefetch goroutines can exit.
+type SubscriberIterator struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	client *Client
+	query  *SubscriberQuery
+	opts   []CallOption
+
+	pages chan pageResult
+
+	mu   sync.Mutex
+	info PageInfo
+
+	buf    []Subscriber
+	bufPos int
+}
+
+type pageResult struct {
+	page *subscriberPage
+	err  error
+}
+
+// SubscribersIter returns an iterator over all confirmed subscribers
+// matching query, starting at the first page.
+func (c *Client) SubscribersIter(ctx context.Context, query *SubscriberQuery, opts ...CallOption) *SubscriberIterator {
+	return c.SubscribersIterFrom(ctx, query, 1, opts...)
+}
+
+// SubscribersIterFrom returns an iterator over all confirmed subscribers
+// matching query, beginning at the given page instead of the first one. This
+// lets a caller doing incremental sync resume from a checkpoint recorded via
+// a previous iterator's PageInfo.
+func (c *Client) SubscribersIterFrom(ctx context.Context, query *SubscriberQuery, page int, opts ...CallOption) *SubscriberIterator {
+	if page < 1 {
+		page = 1
+	}
+
+	iterCtx, cancel := context.WithCancel(ctx)
+	it := &SubscriberIterator{
+		ctx:    iterCtx,
+		cancel: cancel,
+		client: c,
+		query:  query,
+		opts:   opts,
+		pages:  make(chan pageResult, c.config.ConcurrentRequests),
+	}
+	go it.fetchPages(page)
+	return it
+}
+
+// Close stops the iterator from fetching any further pages and releases its
+// prefetch goroutines. It is safe to call more than once, and safe (though
+// unnecessary) to call after Next has returned ErrIteratorDone. Callers that
+// stop calling Next before exhausting the iterator must call Close to avoid
+// leaking goroutines blocked on in-flight page fetches.
+func (it *SubscriberIterator) Close() {
+	it.cancel()
+}
+
+// Next returns the next subscriber, fetching additional pages as needed. It
+// returns ErrIteratorDone once every subscriber has been delivered.
+func (it *SubscriberIterator) Next() (Subscriber, error) {
+	for it.bufPos == len(it.buf) {
+		r, ok := <-it.pages
+		if !ok {
+			// fetchPages may stop on cancellation without delivering the
+			// error, so a done context is reported instead of a clean end.
+			if err := it.ctx.Err(); err != nil {
+				return Subscriber{}, err
+			}
+			return Subscriber{}, ErrIteratorDone
+		}
+		if r.err != nil {
+			return Subscriber{}, r.err
+		}
+
+		it.mu.Lock()
+		it.info.Page = r.page.Page
+		it.info.TotalPages = r.page.TotalPages
+		it.info.TotalSubscribers = r.page.TotalSubscribers
+		it.mu.Unlock()
+
+		it.buf = r.page.Subscribers
+		it.bufPos = 0
+	}
+
+	s := it.buf[it.bufPos]
+	it.bufPos++
+	return s, nil
+}
+
+// PageInfo reports the pagination state as of the most recently delivered
+// subscriber.
+func (it *SubscriberIterator) PageInfo() PageInfo {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.info
+}
+
+// fetchPages drives the pipeline that fills it.pages, starting at startPage.
+// The first page is fetched synchronously to learn TotalPages, then the
+// remaining pages are fetched concurrently (bounded by
+// client.config.ConcurrentRequests) and delivered to it.pages in order.
+func (it *SubscriberIterator) fetchPages(startPage int) {
+	defer close(it.pages)
+
+	first, err := it.client.subscriberPage(it.ctx, startPage, it.query, it.opts...)
+	if err != nil {
+		it.send(pageResult{err: err})
+		return
+	}
+	if !it.send(pageResult{page: first}) {
+		return
+	}
+
+	total := first.TotalPages
+	if total <= startPage {
+		return
+	}
+
+	remaining := total - startPage
+	ordered := make([]chan pageResult, remaining)
+	for i := range ordered {
+		ordered[i] = make(chan pageResult, 1)
+	}
+
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for page := startPage + 1; page <= total; page++ {
+			select {
+			case jobs <- page:
+			case <-it.ctx.Done():
+				return
+			}
+		}
+	}()
+
+	limit := it.client.config.ConcurrentRequests
+	if limit < 1 {
+		limit = 1
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < limit; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range jobs {
+				p, err := it.client.subscriberPage(it.ctx, page, it.query, it.opts...)
+				// ordered[i] is buffered to 1 and written at most once, so
+				// this never blocks even if fetchPages stops reading it.
+				ordered[page-startPage-1] <- pageResult{page: p, err: err}
+			}
+		}()
+	}
+
+	for _, ch := range ordered {
+		select {
+		case r := <-ch:
+			if !it.send(r) || r.err != nil {
+				return
+			}
+		case <-it.ctx.Done():
+			it.send(pageResult{err: it.ctx.Err()})
+			return
+		}
+	}
+}
+
+// send delivers r to it.pages, reporting false instead of blocking forever
+// if the iterator's context is done (e.g. Close was called) before the
+// consumer reads it.
+func (it *SubscriberIterator) send(r pageResult) bool {
+	select {
+	case it.pages <- r:
+		return true
+	case <-it.ctx.Done():
+		return false
+	}
+}
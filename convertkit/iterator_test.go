@@ -0,0 +1,101 @@
+package convertkit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// newPagedTestServer serves /v3/subscribers as totalPages pages, each
+// holding a single subscriber whose ID equals the page number. Later pages
+// respond faster than earlier ones, so a correct iterator has to reorder
+// concurrently-fetched pages to deliver them in sequence.
+func newPagedTestServer(t *testing.T, totalPages int) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page < 1 {
+			page = 1
+		}
+		time.Sleep(time.Duration(totalPages-page) * time.Millisecond)
+
+		json.NewEncoder(w).Encode(subscriberPage{
+			TotalSubscribers: totalPages,
+			Page:             page,
+			TotalPages:       totalPages,
+			Subscribers:      []Subscriber{{ID: page}},
+		})
+	}))
+}
+
+func newPagedTestClient(t *testing.T, endpoint string) *Client {
+	t.Helper()
+	c, err := NewClient(&Config{
+		Endpoint:           endpoint,
+		Secret:             "secret",
+		ConcurrentRequests: 4,
+		RetryPolicy:        &RetryPolicy{MaxAttempts: 1},
+		// Keep-alives off: TestSubscriberIteratorCloseReleasesGoroutines
+		// counts process goroutines before and after Close, and pooled
+		// idle connections from http.DefaultClient would otherwise show
+		// up as leaks that have nothing to do with the iterator.
+		HTTPClient: &http.Client{Transport: &http.Transport{DisableKeepAlives: true}},
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return c
+}
+
+func TestSubscriberIteratorDeliversPagesInOrder(t *testing.T) {
+	const totalPages = 12
+	srv := newPagedTestServer(t, totalPages)
+	defer srv.Close()
+
+	it := newPagedTestClient(t, srv.URL).SubscribersIter(context.Background(), nil)
+	defer it.Close()
+
+	for page := 1; page <= totalPages; page++ {
+		s, err := it.Next()
+		if err != nil {
+			t.Fatalf("Next() at page %d: %v", page, err)
+		}
+		if s.ID != page {
+			t.Fatalf("Next() = subscriber %d, want %d (pages delivered out of order)", s.ID, page)
+		}
+	}
+
+	if _, err := it.Next(); err != ErrIteratorDone {
+		t.Fatalf("Next() after exhaustion = %v, want ErrIteratorDone", err)
+	}
+}
+
+func TestSubscriberIteratorCloseReleasesGoroutines(t *testing.T) {
+	const totalPages = 50
+	srv := newPagedTestServer(t, totalPages)
+	defer srv.Close()
+
+	c := newPagedTestClient(t, srv.URL)
+
+	before := runtime.NumGoroutine()
+
+	it := c.SubscribersIter(context.Background(), nil)
+	if _, err := it.Next(); err != nil {
+		t.Fatalf("Next(): %v", err)
+	}
+	it.Close()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if after := runtime.NumGoroutine(); after > before {
+		t.Fatalf("goroutines leaked after Close(): before=%d after=%d", before, after)
+	}
+}
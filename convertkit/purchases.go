@@ -0,0 +1,87 @@
+package convertkit
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PurchaseProduct is a single line item of a Purchase.
+type PurchaseProduct struct {
+	Name      string  `json:"name"`
+	SKU       string  `json:"sku"`
+	UnitPrice float64 `json:"unit_price"`
+	Quantity  int     `json:"quantity"`
+}
+
+// Purchase describes a purchase recorded against a subscriber.
+type Purchase struct {
+	ID            int               `json:"id"`
+	TransactionID string            `json:"transaction_id"`
+	EmailAddress  string            `json:"email_address"`
+	Status        string            `json:"status"`
+	Products      []PurchaseProduct `json:"products"`
+	CreatedAt     time.Time         `json:"created_at"`
+}
+
+// CreatePurchaseRequest is the set of parameters used to record a purchase.
+type CreatePurchaseRequest struct {
+	EmailAddress  string            `json:"email_address"`
+	TransactionID string            `json:"transaction_id"`
+	Products      []PurchaseProduct `json:"products"`
+}
+
+type purchasePage struct {
+	TotalPurchases int        `json:"total_purchases"`
+	Page           int        `json:"page"`
+	TotalPages     int        `json:"total_pages"`
+	Purchases      []Purchase `json:"purchases"`
+}
+
+// ListPurchases returns every purchase recorded for the account.
+func (c *Client) ListPurchases(ctx context.Context, opts ...CallOption) ([]Purchase, error) {
+	var purchases []Purchase
+	for page := 1; ; page++ {
+		p, err := c.purchasePage(ctx, page, opts...)
+		if err != nil {
+			return nil, err
+		}
+		purchases = append(purchases, p.Purchases...)
+		if page >= p.TotalPages {
+			break
+		}
+	}
+	return purchases, nil
+}
+
+func (c *Client) purchasePage(ctx context.Context, page int, opts ...CallOption) (*purchasePage, error) {
+	if c.config.Secret == "" {
+		return nil, ErrSecretMissing
+	}
+
+	url := fmt.Sprintf("%s/v3/purchases?api_secret=%s&page=%d", c.config.Endpoint, c.config.Secret, page)
+	var p purchasePage
+	if err := c.sendRequest(ctx, "GET", url, nil, &p, opts...); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// CreatePurchase records a new purchase, creating the subscriber with the
+// given email address if one does not already exist.
+func (c *Client) CreatePurchase(ctx context.Context, req CreatePurchaseRequest, opts ...CallOption) (Purchase, error) {
+	if c.config.Secret == "" {
+		return Purchase{}, ErrSecretMissing
+	}
+
+	body := struct {
+		APISecret string                `json:"api_secret"`
+		Purchase  CreatePurchaseRequest `json:"purchase"`
+	}{APISecret: c.config.Secret, Purchase: req}
+
+	var resp struct {
+		Purchase Purchase `json:"purchase"`
+	}
+	err := c.postJSON(ctx, "/v3/purchases", body, &resp, opts...)
+	return resp.Purchase, err
+}
@@ -0,0 +1,63 @@
+package convertkit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// defaultRateLimit and defaultRateBurst hold the sustained rate to
+// ConvertKit's documented 120 requests/minute cap (2 requests/sec); a full
+// burst of 10 can briefly exceed it.
+const (
+	defaultRateLimit = rate.Limit(2)
+	defaultRateBurst = 10
+)
+
+// rateLimiter wraps rate.Limiter with the ability to temporarily halve its
+// rate after a 429 response, recovering once the server's
+// Retry-After window has elapsed.
+type rateLimiter struct {
+	limiter *rate.Limiter
+	base    rate.Limit
+
+	mu      sync.Mutex
+	reduced bool
+	resetAt time.Time
+}
+
+func newRateLimiter(limit rate.Limit, burst int) *rateLimiter {
+	return &rateLimiter{
+		limiter: rate.NewLimiter(limit, burst),
+		base:    limit,
+	}
+}
+
+// Wait blocks until the limiter permits another request, lifting a prior
+// throttle once its reset time has passed.
+func (rl *rateLimiter) Wait(ctx context.Context) error {
+	rl.mu.Lock()
+	if rl.reduced && !rl.resetAt.IsZero() && time.Now().After(rl.resetAt) {
+		rl.limiter.SetLimit(rl.base)
+		rl.reduced = false
+	}
+	rl.mu.Unlock()
+
+	return rl.limiter.Wait(ctx)
+}
+
+// throttle halves the limiter's rate until resetAt, to back off after a 429.
+func (rl *rateLimiter) throttle(resetAt time.Time) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if !rl.reduced {
+		rl.limiter.SetLimit(rl.base / 2)
+		rl.reduced = true
+	}
+	if resetAt.After(rl.resetAt) {
+		rl.resetAt = resetAt
+	}
+}
@@ -0,0 +1,77 @@
+package convertkit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimiterWaitRespectsLimit(t *testing.T) {
+	rl := newRateLimiter(rate.Limit(1000), 1)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := rl.Wait(context.Background()); err != nil {
+			t.Fatalf("Wait: %v", err)
+		}
+	}
+
+	elapsed := time.Since(start)
+	// Burst 1 at 1000/s spaces the second and third tokens ~1ms apart each.
+	if elapsed < time.Millisecond {
+		t.Fatalf("Wait took %v, want at least 1ms for 3 tokens with burst 1 at 1000/s", elapsed)
+	}
+	if elapsed > time.Second {
+		t.Fatalf("Wait took %v, want well under 1s at this rate", elapsed)
+	}
+}
+
+func TestRateLimiterThrottleHalvesRateUntilReset(t *testing.T) {
+	rl := newRateLimiter(rate.Limit(100), 1)
+
+	rl.throttle(time.Now().Add(-time.Millisecond))
+
+	rl.mu.Lock()
+	reduced := rl.reduced
+	rl.mu.Unlock()
+	if !reduced {
+		t.Fatal("throttle did not mark the limiter reduced")
+	}
+	if got := rl.limiter.Limit(); got != rate.Limit(50) {
+		t.Fatalf("limit after throttle = %v, want 50", got)
+	}
+
+	if err := rl.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+
+	rl.mu.Lock()
+	reduced = rl.reduced
+	rl.mu.Unlock()
+	if reduced {
+		t.Fatal("Wait did not lift the throttle after resetAt had passed")
+	}
+	if got := rl.limiter.Limit(); got != rate.Limit(100) {
+		t.Fatalf("limit after reset = %v, want 100", got)
+	}
+}
+
+func TestRateLimiterThrottleKeepsLatestResetAt(t *testing.T) {
+	rl := newRateLimiter(rate.Limit(100), 1)
+
+	earlier := time.Now().Add(time.Minute)
+	later := time.Now().Add(time.Hour)
+
+	rl.throttle(later)
+	rl.throttle(earlier)
+
+	rl.mu.Lock()
+	resetAt := rl.resetAt
+	rl.mu.Unlock()
+
+	if !resetAt.Equal(later) {
+		t.Fatalf("resetAt = %v, want %v (the later of the two)", resetAt, later)
+	}
+}
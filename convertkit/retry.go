@@ -0,0 +1,204 @@
+package convertkit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the client retries a failed call. The zero
+// RetryPolicy makes a single attempt and so disables retries; a nil
+// Config.RetryPolicy is replaced with DefaultRetryPolicy() by NewClient.
+type RetryPolicy struct {
+	// Initial is the backoff before the first retry.
+	Initial time.Duration
+
+	// Max is the largest backoff between retries.
+	Max time.Duration
+
+	// Multiplier scales the backoff after each retry.
+	Multiplier float64
+
+	// MaxAttempts is the total number of attempts made, including the first,
+	// before giving up.
+	MaxAttempts int
+
+	// Retryable reports whether a call should be retried given its response
+	// and/or error. resp is nil when the request itself failed. Defaults to
+	// retrying 429, 502, 503, 504, and timed-out net.Error failures.
+	Retryable func(resp *http.Response, err error) bool
+}
+
+// DefaultRetryPolicy is the RetryPolicy used by clients that don't set
+// Config.RetryPolicy: up to 4 attempts with exponential backoff starting at
+// 500ms and capped at 30s, retrying 429/502/503/504 responses and timed-out
+// network errors.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		Initial:     500 * time.Millisecond,
+		Max:         30 * time.Second,
+		Multiplier:  2,
+		MaxAttempts: 4,
+		Retryable:   defaultRetryable,
+	}
+}
+
+func defaultRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		var netErr net.Error
+		return errors.As(err, &netErr) && netErr.Timeout()
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// CallOption customizes the behavior of a single API call, overriding
+// whatever the Client or Config otherwise specify for that call only.
+type CallOption interface {
+	apply(*callSettings)
+}
+
+type callSettings struct {
+	retry *RetryPolicy
+}
+
+func (c *Client) callSettings(opts []CallOption) *callSettings {
+	s := &callSettings{retry: c.config.RetryPolicy}
+	for _, o := range opts {
+		o.apply(s)
+	}
+	return s
+}
+
+type retryPolicyOption struct {
+	policy *RetryPolicy
+}
+
+func (o retryPolicyOption) apply(s *callSettings) { s.retry = o.policy }
+
+// WithRetryPolicy overrides the RetryPolicy for a single call. Pass a zero
+// RetryPolicy{} to disable retries for that call.
+func WithRetryPolicy(p RetryPolicy) CallOption {
+	return retryPolicyOption{policy: &p}
+}
+
+// doWithRetry performs an HTTP request, retrying according to policy. body
+// may be nil (e.g. for GET requests); when non-nil it is re-sent unchanged on
+// every attempt. The caller is responsible for closing the returned
+// response's body.
+func (c *Client) doWithRetry(ctx context.Context, method, url string, headers map[string]string, body []byte, policy *RetryPolicy) (*http.Response, error) {
+	attempts := 1
+	if policy != nil && policy.MaxAttempts > 0 {
+		attempts = policy.MaxAttempts
+	}
+	backoff := time.Duration(0)
+	if policy != nil {
+		backoff = policy.Initial
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		var bodyReader io.Reader
+		if body != nil {
+			bodyReader = bytes.NewReader(body)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+
+		resp, err := c.config.HTTPClient.Do(req)
+
+		retryable := defaultRetryable
+		if policy != nil && policy.Retryable != nil {
+			retryable = policy.Retryable
+		}
+		retry := attempt < attempts && policy != nil && retryable(resp, err)
+		if err == nil && resp.StatusCode == http.StatusOK {
+			return resp, nil
+		}
+
+		if err == nil {
+			lastErr = fmt.Errorf("HTTP error: %s", resp.Status)
+			wait := retryAfter(resp, backoff)
+			if resp.StatusCode == http.StatusTooManyRequests {
+				c.limiter.throttle(time.Now().Add(wait))
+			}
+			resp.Body.Close()
+			if !retry {
+				return nil, lastErr
+			}
+			if sleepErr := sleepContext(ctx, wait); sleepErr != nil {
+				return nil, sleepErr
+			}
+		} else {
+			lastErr = err
+			if !retry {
+				return nil, lastErr
+			}
+			if sleepErr := sleepContext(ctx, backoff); sleepErr != nil {
+				return nil, sleepErr
+			}
+		}
+
+		if policy != nil {
+			backoff = time.Duration(float64(backoff) * policy.Multiplier)
+			if policy.Max > 0 && backoff > policy.Max {
+				backoff = policy.Max
+			}
+		}
+	}
+
+	return nil, lastErr
+}
+
+// retryAfter returns how long to wait before the next attempt, honouring the
+// response's Retry-After header (seconds or HTTP-date) when present and
+// falling back to fallback otherwise.
+func retryAfter(resp *http.Response, fallback time.Duration) time.Duration {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return fallback
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if when, err := http.ParseTime(v); err == nil {
+		if d := time.Until(when); d > 0 {
+			return d
+		}
+	}
+	return fallback
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,123 @@
+package convertkit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func newRetryTestClient(t *testing.T, endpoint string, policy *RetryPolicy) *Client {
+	t.Helper()
+	c, err := NewClient(&Config{
+		Endpoint:    endpoint,
+		Secret:      "secret",
+		RetryPolicy: policy,
+		RateLimit:   1000,
+		RateBurst:   1000,
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return c
+}
+
+func TestDoWithRetryRetriesOnServiceUnavailable(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, &RetryPolicy{
+		MaxAttempts: 4,
+		Retryable:   defaultRetryable,
+	})
+
+	resp, err := c.doWithRetry(context.Background(), "GET", srv.URL, nil, nil, c.config.RetryPolicy)
+	if err != nil {
+		t.Fatalf("doWithRetry: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Fatalf("attempts = %d, want 3", got)
+	}
+}
+
+func TestDoWithRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, &RetryPolicy{
+		MaxAttempts: 2,
+		Retryable:   defaultRetryable,
+	})
+
+	_, err := c.doWithRetry(context.Background(), "GET", srv.URL, nil, nil, c.config.RetryPolicy)
+	if err == nil {
+		t.Fatal("doWithRetry: want error, got nil")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Fatalf("attempts = %d, want 2", got)
+	}
+}
+
+func TestDoWithRetryHonoursRetryAfterSeconds(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, &RetryPolicy{
+		MaxAttempts: 2,
+		Retryable:   defaultRetryable,
+	})
+
+	resp, err := c.doWithRetry(context.Background(), "GET", srv.URL, nil, nil, c.config.RetryPolicy)
+	if err != nil {
+		t.Fatalf("doWithRetry: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Fatalf("attempts = %d, want 2", got)
+	}
+}
+
+func TestDoWithRetryDoesNotRetryNonRetryableStatus(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, &RetryPolicy{
+		MaxAttempts: 4,
+		Retryable:   defaultRetryable,
+	})
+
+	_, err := c.doWithRetry(context.Background(), "GET", srv.URL, nil, nil, c.config.RetryPolicy)
+	if err == nil {
+		t.Fatal("doWithRetry: want error, got nil")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Fatalf("attempts = %d, want 1", got)
+	}
+}
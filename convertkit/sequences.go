@@ -0,0 +1,45 @@
+package convertkit
+
+import (
+	"context"
+	"fmt"
+)
+
+// Sequence is a ConvertKit email sequence (also called a "course").
+type Sequence struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ListSequences returns every sequence defined in the account.
+func (c *Client) ListSequences(ctx context.Context, opts ...CallOption) ([]Sequence, error) {
+	if c.config.Secret == "" {
+		return nil, ErrSecretMissing
+	}
+
+	url := fmt.Sprintf("%s/v3/sequences?api_secret=%s", c.config.Endpoint, c.config.Secret)
+	var resp struct {
+		Sequences []Sequence `json:"courses"`
+	}
+	if err := c.sendRequest(ctx, "GET", url, nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp.Sequences, nil
+}
+
+// AddSubscriberToSequence subscribes email to the given sequence.
+//
+// This method will also create a subscriber with the email address provided if one does not exist.
+func (c *Client) AddSubscriberToSequence(ctx context.Context, sequenceID int, email string, opts ...CallOption) (Subscription, error) {
+	body := struct {
+		APIKey string `json:"api_key"`
+		Email  string `json:"email"`
+	}{APIKey: c.config.Key, Email: email}
+
+	path := fmt.Sprintf("/v3/sequences/%d/subscribe", sequenceID)
+	var resp struct {
+		Subscription Subscription `json:"subscription"`
+	}
+	err := c.postJSON(ctx, path, body, &resp, opts...)
+	return resp.Subscription, err
+}
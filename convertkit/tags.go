@@ -0,0 +1,63 @@
+package convertkit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Tag is a label that can be applied to a subscriber.
+type Tag struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ListTags returns every tag defined in the account.
+func (c *Client) ListTags(ctx context.Context, opts ...CallOption) ([]Tag, error) {
+	if c.config.Secret == "" {
+		return nil, ErrSecretMissing
+	}
+
+	url := fmt.Sprintf("%s/v3/tags?api_secret=%s", c.config.Endpoint, c.config.Secret)
+	var resp struct {
+		Tags []Tag `json:"tags"`
+	}
+	if err := c.sendRequest(ctx, "GET", url, nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp.Tags, nil
+}
+
+// CreateTag creates a new tag with the given name.
+func (c *Client) CreateTag(ctx context.Context, name string, opts ...CallOption) (Tag, error) {
+	if c.config.Secret == "" {
+		return Tag{}, ErrSecretMissing
+	}
+
+	body := struct {
+		APISecret string `json:"api_secret"`
+		Tag       struct {
+			Name string `json:"name"`
+		} `json:"tag"`
+	}{APISecret: c.config.Secret}
+	body.Tag.Name = name
+
+	var tag Tag
+	err := c.postJSON(ctx, "/v3/tags", body, &tag, opts...)
+	return tag, err
+}
+
+// RemoveTagFromSubscriber removes tagID from the subscriber with the given
+// email address.
+func (c *Client) RemoveTagFromSubscriber(ctx context.Context, tagID int, email string, opts ...CallOption) error {
+	body, err := json.Marshal(struct {
+		APIKey string `json:"api_key"`
+		Email  string `json:"email"`
+	}{APIKey: c.config.Key, Email: email})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/v3/tags/%d/unsubscribe", c.config.Endpoint, tagID)
+	return c.doNoContent(ctx, "POST", url, body, opts...)
+}
@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"videofruit/ck/convertkit"
+)
+
+// Webhook describes a registered ConvertKit automation webhook.
+type Webhook struct {
+	ID        int          `json:"id"`
+	TargetURL string       `json:"target_url"`
+	Event     WebhookEvent `json:"event"`
+}
+
+// WebhookEvent is the event a Webhook is registered for. Only the event name
+// is decoded; filters that scope the registration (e.g. "tag_id") are not
+// kept.
+type WebhookEvent struct {
+	Name string `json:"name"`
+}
+
+// Client manages ConvertKit automation webhooks, i.e. the
+// /v3/automations/hooks endpoints. Create one with NewClient. It reuses the
+// embedded *convertkit.Client's transport, so webhook calls get the same
+// retry/backoff and rate limiting as the rest of the API.
+type Client struct {
+	*convertkit.Client
+}
+
+// NewClient returns a new webhook management Client backed by ck.
+func NewClient(ck *convertkit.Client) *Client {
+	return &Client{Client: ck}
+}
+
+// CreateWebhook registers targetURL to be called whenever event fires.
+// params adds event-specific filters ConvertKit supports for that event
+// (e.g. {"tag_id": "123"} to scope an EventTagAdd registration to one tag).
+func (c *Client) CreateWebhook(ctx context.Context, targetURL, event string, params map[string]string) (Webhook, error) {
+	ev := map[string]string{"name": event}
+	for k, v := range params {
+		ev[k] = v
+	}
+
+	reqBody := struct {
+		APISecret string            `json:"api_secret"`
+		TargetURL string            `json:"target_url"`
+		Event     map[string]string `json:"event"`
+	}{
+		APISecret: c.Secret(),
+		TargetURL: targetURL,
+		Event:     ev,
+	}
+
+	var resp struct {
+		Rule Webhook `json:"rule"`
+	}
+	err := c.Call(ctx, "POST", "/v3/automations/hooks", reqBody, &resp)
+	return resp.Rule, err
+}
+
+// DestroyWebhook removes the webhook with the given id.
+func (c *Client) DestroyWebhook(ctx context.Context, id int) error {
+	path := fmt.Sprintf("/v3/automations/hooks/%d?api_secret=%s", id, c.Secret())
+	return c.Call(ctx, "DELETE", path, nil, nil)
+}
+
+// ListWebhooks returns every automation webhook registered for the account.
+func (c *Client) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	path := fmt.Sprintf("/v3/automations/hooks?api_secret=%s", c.Secret())
+
+	var resp struct {
+		Rules []Webhook `json:"rules"`
+	}
+	if err := c.Call(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Rules, nil
+}
@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"videofruit/ck/convertkit"
+)
+
+func newWebhookTestClient(t *testing.T, endpoint string) *Client {
+	t.Helper()
+	ck, err := convertkit.NewClient(&convertkit.Config{
+		Endpoint: endpoint,
+		Secret:   "secret",
+	})
+	if err != nil {
+		t.Fatalf("convertkit.NewClient: %v", err)
+	}
+	return NewClient(ck)
+}
+
+func TestCreateWebhook(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/automations/hooks" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			APISecret string            `json:"api_secret"`
+			TargetURL string            `json:"target_url"`
+			Event     map[string]string `json:"event"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if body.APISecret != "secret" {
+			t.Fatalf("api_secret = %q, want %q", body.APISecret, "secret")
+		}
+		if body.TargetURL != "https://example.com/hooks" {
+			t.Fatalf("target_url = %q, want %q", body.TargetURL, "https://example.com/hooks")
+		}
+		if body.Event["name"] != EventTagAdd || body.Event["tag_id"] != "7" {
+			t.Fatalf("event = %+v, want name=%q tag_id=%q", body.Event, EventTagAdd, "7")
+		}
+
+		json.NewEncoder(w).Encode(struct {
+			Rule Webhook `json:"rule"`
+		}{Rule: Webhook{ID: 1, TargetURL: body.TargetURL, Event: WebhookEvent{Name: body.Event["name"]}}})
+	}))
+	defer srv.Close()
+
+	c := newWebhookTestClient(t, srv.URL)
+
+	hook, err := c.CreateWebhook(context.Background(), "https://example.com/hooks", EventTagAdd, map[string]string{"tag_id": "7"})
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	if hook.ID != 1 || hook.Event.Name != EventTagAdd {
+		t.Fatalf("CreateWebhook = %+v, want id=1 event.name=%q", hook, EventTagAdd)
+	}
+}
+
+func TestListWebhooksAndDestroyWebhook(t *testing.T) {
+	var destroyed bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/automations/hooks":
+			if r.URL.Query().Get("api_secret") != "secret" {
+				t.Fatalf("api_secret = %q, want %q", r.URL.Query().Get("api_secret"), "secret")
+			}
+			json.NewEncoder(w).Encode(struct {
+				Rules []Webhook `json:"rules"`
+			}{Rules: []Webhook{{ID: 1, TargetURL: "https://example.com/hooks", Event: WebhookEvent{Name: EventTagAdd}}}})
+		case r.Method == http.MethodDelete && r.URL.Path == "/v3/automations/hooks/1":
+			destroyed = true
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := newWebhookTestClient(t, srv.URL)
+
+	hooks, err := c.ListWebhooks(context.Background())
+	if err != nil {
+		t.Fatalf("ListWebhooks: %v", err)
+	}
+	if len(hooks) != 1 || hooks[0].Event.Name != EventTagAdd {
+		t.Fatalf("ListWebhooks = %+v, want one hook for %q", hooks, EventTagAdd)
+	}
+
+	if err := c.DestroyWebhook(context.Background(), hooks[0].ID); err != nil {
+		t.Fatalf("DestroyWebhook: %v", err)
+	}
+	if !destroyed {
+		t.Fatal("DestroyWebhook did not reach the server")
+	}
+}
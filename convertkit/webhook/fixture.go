@@ -0,0 +1,21 @@
+package webhook
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+)
+
+// Replay delivers payload to h as ConvertKit would when event fires, and
+// returns the recorded response. It is meant for use from tests that
+// exercise a Handler's registered callbacks without standing up a real HTTP
+// server, e.g. replaying a saved fixture payload captured from a real
+// delivery.
+func Replay(h http.Handler, event string, payload []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/?event="+event, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
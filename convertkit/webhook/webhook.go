@@ -0,0 +1,103 @@
+// Package webhook dispatches ConvertKit automation webhook deliveries to
+// registered per-event callbacks, and manages webhook registrations through
+// the ConvertKit v3 API.
+// See https://developers.convertkit.com/#webhooks
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+
+	"videofruit/ck/convertkit"
+)
+
+// Event names as sent in the "event.name" of a ConvertKit automation
+// webhook registration.
+const (
+	EventSubscriberActivate = "subscriber.subscriber_activate"
+	EventFormSubscribe      = "form_subscribe"
+	EventTagAdd             = "tag_add"
+	EventPurchaseCreate     = "purchase.purchase_create"
+)
+
+// SubscriberActivateEvent is the payload of a subscriber.subscriber_activate webhook.
+type SubscriberActivateEvent struct {
+	Subscriber convertkit.Subscriber `json:"subscriber"`
+}
+
+// FormSubscribeEvent is the payload of a form_subscribe webhook.
+type FormSubscribeEvent struct {
+	Subscriber convertkit.Subscriber `json:"subscriber"`
+	Form       convertkit.Form       `json:"form"`
+}
+
+// TagAddEvent is the payload of a tag_add webhook.
+type TagAddEvent struct {
+	Subscriber convertkit.Subscriber `json:"subscriber"`
+	Tag        convertkit.Tag        `json:"tag"`
+}
+
+// PurchaseCreateEvent is the payload of a purchase.purchase_create webhook.
+type PurchaseCreateEvent struct {
+	Purchase convertkit.Purchase `json:"purchase"`
+}
+
+// EventHandlerFunc handles one delivery of the named event. raw is the
+// undecoded request body; decode it into the struct matching the event, e.g.
+// a TagAddEvent for EventTagAdd.
+type EventHandlerFunc func(ctx context.Context, raw json.RawMessage) error
+
+// Handler is an http.Handler that dispatches ConvertKit webhook deliveries to
+// callbacks registered with On, keyed by event name. ConvertKit registers one
+// target URL per event, so Handler expects the event name as the "event"
+// query parameter of that URL (append it when calling CreateWebhook).
+type Handler struct {
+	mu       sync.RWMutex
+	handlers map[string][]EventHandlerFunc
+}
+
+// NewHandler returns an empty Handler ready to have callbacks registered
+// with On.
+func NewHandler() *Handler {
+	return &Handler{handlers: make(map[string][]EventHandlerFunc)}
+}
+
+// On registers fn to be called for every delivery of event. Multiple
+// callbacks may be registered for the same event; they run in registration
+// order.
+func (h *Handler) On(event string, fn EventHandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[event] = append(h.handlers[event], fn)
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	event := r.URL.Query().Get("event")
+	if event == "" {
+		http.Error(w, "webhook: missing event query parameter", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.mu.RLock()
+	fns := h.handlers[event]
+	h.mu.RUnlock()
+
+	for _, fn := range fns {
+		if err := fn(r.Context(), json.RawMessage(body)); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
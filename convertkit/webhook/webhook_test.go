@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestHandlerDispatchesByEvent(t *testing.T) {
+	h := NewHandler()
+
+	var got TagAddEvent
+	h.On(EventTagAdd, func(ctx context.Context, raw json.RawMessage) error {
+		return json.Unmarshal(raw, &got)
+	})
+
+	payload := []byte(`{"subscriber":{"id":1,"email_address":"a@example.com"},"tag":{"id":7,"name":"customer"}}`)
+	rec := Replay(h, EventTagAdd, payload)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if got.Subscriber.ID != 1 || got.Tag.ID != 7 {
+		t.Fatalf("got = %+v, want subscriber.id=1 tag.id=7", got)
+	}
+}
+
+func TestHandlerMissingEvent(t *testing.T) {
+	h := NewHandler()
+
+	rec := Replay(h, "", []byte(`{}`))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}